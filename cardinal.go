@@ -2,21 +2,33 @@
 //
 // Before getting started there a few basic rules you need to understand:
 //
-// 	1. To reject a promise return a non-nil error
+//  1. To reject a promise return a non-nil error
 //
-// 	2. Nil errors will not be piped into the next chained function
+//  2. Nil errors will not be piped into the next chained function
 package cardinal
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"time"
 )
 
+// ErrTimeout is the rejection reason reported once the deadline set by Timeout elapses before
+// the chain settles
+var ErrTimeout = errors.New("cardinal: promise timed out")
+
 // PromiseStruct holds the data for running the Promise
 type PromiseStruct struct {
 	Status stat            // Describes the success of the previously ran promise
 	Result []reflect.Value // Holds the function output arguments of the previously ran promise
 	Order  int             // For promise methods that return multiple promises, this is used to keep their return arguments in order
+
+	ctx         context.Context    // Set via PromiseWithContext/WithContext; observed by All and Map
+	cancel      context.CancelFunc // Cancels ctx; All/Map cancel siblings through a child context of their own, not through this
+	concurrency int                // Set via WithConcurrency; caps the number of workers All/Map run at once
+	timeoutSet  bool               // Set via Timeout; tells ctxRejection the deadline is this library's own, not one the caller passed into WithContext
 }
 
 // Define Promise statuses
@@ -31,9 +43,9 @@ const (
 // Initialize Promise Object
 var promise = PromiseStruct{Status: pending}
 
-// Function for running any function described as an interface{}
+// Function for running any function described as an any
 // All promise methods must call this function
-func (p PromiseStruct) runFunc(fn interface{}) PromiseStruct {
+func (p PromiseStruct) runFunc(fn any) PromiseStruct {
 	// Get pointer to passed in function
 	fnPtr := reflect.ValueOf(fn)
 	fnTyp := reflect.TypeOf(fn)
@@ -42,8 +54,12 @@ func (p PromiseStruct) runFunc(fn interface{}) PromiseStruct {
 	if fnPtr.Kind() != reflect.Func {
 		message := fmt.Errorf("was expecting a %s but got %s", reflect.Func, fnPtr.Kind())
 		return PromiseStruct{
-			Status: rejected,
-			Result: []reflect.Value{reflect.ValueOf(message)},
+			Status:      rejected,
+			Result:      []reflect.Value{reflect.ValueOf(message)},
+			ctx:         p.ctx,
+			cancel:      p.cancel,
+			concurrency: p.concurrency,
+			timeoutSet:  p.timeoutSet,
 		}
 	}
 
@@ -56,8 +72,12 @@ func (p PromiseStruct) runFunc(fn interface{}) PromiseStruct {
 		if fnTyp.In(i) != p.Result[i].Type() && !fnTyp.In(i).Implements(reflectError) && !p.Result[i].Type().Implements(reflectError) {
 			message := fmt.Errorf("Args should be %s but got %s", fnTyp.In(i), p.Result[i].Type())
 			return PromiseStruct{
-				Status: rejected,
-				Result: []reflect.Value{reflect.ValueOf(message)},
+				Status:      rejected,
+				Result:      []reflect.Value{reflect.ValueOf(message)},
+				ctx:         p.ctx,
+				cancel:      p.cancel,
+				concurrency: p.concurrency,
+				timeoutSet:  p.timeoutSet,
 			}
 		}
 	}
@@ -81,8 +101,53 @@ func (p PromiseStruct) runFunc(fn interface{}) PromiseStruct {
 	}
 
 	return PromiseStruct{
-		Status: resolveStatus,
-		Result: values,
-		Order:  p.Order,
+		Status:      resolveStatus,
+		Result:      values,
+		Order:       p.Order,
+		ctx:         p.ctx,
+		cancel:      p.cancel,
+		concurrency: p.concurrency,
+		timeoutSet:  p.timeoutSet,
+	}
+}
+
+// WithContext attaches ctx to the promise chain. All and Map observe ctx.Done() and abandon
+// their wait loop, rejecting with ctx.Err(), as soon as it fires; a sibling that rejects
+// cancels the rest through the context derived internally from ctx.
+func (p PromiseStruct) WithContext(ctx context.Context) PromiseStruct {
+	p.ctx, p.cancel = context.WithCancel(ctx)
+	// ctx replaces any deadline an earlier Timeout attached, so its expiry is no longer the library's own
+	p.timeoutSet = false
+	return p
+}
+
+// WithConcurrency caps the number of workers All and Map run at once to n, gating them through
+// a buffered semaphore instead of launching one unbounded goroutine per function/element.
+func (p PromiseStruct) WithConcurrency(n int) PromiseStruct {
+	p.concurrency = n
+	return p
+}
+
+// Timeout attaches a deadline of d to the promise chain, reusing the same cancellation path as
+// WithContext: any All, Map, Race, or Any chained after it rejects with ErrTimeout if it has not
+// already settled once d elapses.
+func (p PromiseStruct) Timeout(d time.Duration) PromiseStruct {
+	parent := p.ctx
+	if parent == nil {
+		parent = context.Background()
+	}
+	p.ctx, p.cancel = context.WithTimeout(parent, d)
+	p.timeoutSet = true
+	return p
+}
+
+// ctxRejection turns ctx.Err() into the value All, Map, Race, and Any report as their rejection
+// reason. It only substitutes ErrTimeout for a context.DeadlineExceeded when timeoutSet is true,
+// i.e. the deadline was set by this library's own Timeout; a deadline on a context the caller
+// passed into WithContext directly surfaces as the plain context.DeadlineExceeded it is.
+func ctxRejection(ctx context.Context, timeoutSet bool) reflect.Value {
+	if timeoutSet && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return reflect.ValueOf(ErrTimeout)
 	}
+	return reflect.ValueOf(ctx.Err())
 }
@@ -2,18 +2,181 @@
 //
 // Before getting started there a few basic rules you need to understand:
 //
-// 	1. To reject a promise return a non-nil error
+//  1. To reject a promise return a non-nil error
 //
-// 	2. Nil errors will not be piped into the next chained function
+//  2. Nil errors will not be piped into the next chained function
 package cardinal
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"reflect"
 )
 
+// resultElemType derives the slice element type buildTypedSlice would produce for a worker
+// function's return shape: the lone non-error output type, or an auto-generated struct with
+// fields V0, V1, ... when it returns more than one. It lets buildTypedSlice stay typed even
+// when there are no worker results to inspect directly, e.g. an empty input slice.
+func resultElemType(fn any) reflect.Type {
+	fnTyp := reflect.TypeOf(fn)
+	reflectError := reflect.TypeOf((*error)(nil)).Elem()
+
+	outs := make([]reflect.Type, 0, fnTyp.NumOut())
+	for i := 0; i < fnTyp.NumOut(); i++ {
+		if !fnTyp.Out(i).Implements(reflectError) {
+			outs = append(outs, fnTyp.Out(i))
+		}
+	}
+
+	if len(outs) == 1 {
+		return outs[0]
+	}
+
+	fields := make([]reflect.StructField, len(outs))
+	for j, t := range outs {
+		fields[j] = reflect.StructField{
+			Name: fmt.Sprintf("V%d", j),
+			Type: t,
+		}
+	}
+	return reflect.StructOf(fields)
+}
+
+// buildTypedSlice turns the ordered per-worker results collected by AllSlice/MapSlice into a
+// single reflect.Value holding a genuine []T. When each worker returns more than one value,
+// the element type is instead an auto-generated struct with fields V0, V1, ... so the shape of
+// every return is preserved. elemFn supplies a representative worker function so the result
+// stays typed even when results is empty; pass nil when no such function is available (e.g.
+// AllSlice called with zero functions), in which case the slice falls back to being untyped.
+// AllSlice allows heterogeneous functions, so every result's shape is checked against the
+// first one; a mismatch is reported as an error instead of panicking on reflect.Value.Set.
+func buildTypedSlice(results [][]reflect.Value, elemFn any) (reflect.Value, error) {
+	if len(results) == 0 {
+		if elemFn == nil {
+			return reflect.ValueOf([]any{}), nil
+		}
+		return reflect.MakeSlice(reflect.SliceOf(resultElemType(elemFn)), 0, 0), nil
+	}
+
+	n := len(results[0])
+	for _, r := range results {
+		if len(r) != n {
+			return reflect.Value{}, fmt.Errorf("AllSlice requires every function to return the same number of values, but got %d and %d", n, len(r))
+		}
+	}
+	if n == 1 {
+		outType := results[0][0].Type()
+		out := reflect.MakeSlice(reflect.SliceOf(outType), len(results), len(results))
+		for i, r := range results {
+			if r[0].Type() != outType {
+				return reflect.Value{}, fmt.Errorf("AllSlice requires every function to return the same type, but got %s and %s", outType, r[0].Type())
+			}
+			out.Index(i).Set(r[0])
+		}
+		return out, nil
+	}
+
+	fields := make([]reflect.StructField, n)
+	for j := 0; j < n; j++ {
+		fields[j] = reflect.StructField{
+			Name: fmt.Sprintf("V%d", j),
+			Type: results[0][j].Type(),
+		}
+	}
+	structTyp := reflect.StructOf(fields)
+
+	out := reflect.MakeSlice(reflect.SliceOf(structTyp), len(results), len(results))
+	for i, r := range results {
+		v := reflect.New(structTyp).Elem()
+		for j := 0; j < n; j++ {
+			if r[j].Type() != fields[j].Type {
+				return reflect.Value{}, fmt.Errorf("AllSlice requires every function to return the same types, but got %s and %s", fields[j].Type, r[j].Type())
+			}
+			v.Field(j).Set(r[j])
+		}
+		out.Index(i).Set(v)
+	}
+	return out, nil
+}
+
+// runWorkers runs n workers, each produced by spawn(ind), honoring p.ctx and p.concurrency.
+// A worker that rejects cancels the rest once its rejection has been handed to the wait loop,
+// and the wait loop abandons early as soon as ctx is done: with the rejections received so far,
+// or with ctx.Err() when none has been received. All and Map build their workers from spawn and share this loop so that
+// context cancellation and concurrency limiting only need to be implemented once.
+func (p PromiseStruct) runWorkers(n int, spawn func(ind int) (fn any, args []reflect.Value)) (stat, [][]reflect.Value) {
+	parent := p.ctx
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	var sem chan struct{}
+	if p.concurrency > 0 {
+		sem = make(chan struct{}, p.concurrency)
+	}
+
+	done := make(chan PromiseStruct)
+
+	for ind := 0; ind < n; ind++ {
+		go func(ind int) {
+			if sem != nil {
+				select {
+				case sem <- struct{}{}:
+					defer func() { <-sem }()
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+
+			fptr, args := spawn(ind)
+			resolution := PromiseStruct{Status: p.Status, Result: args, Order: ind}.runFunc(fptr)
+
+			select {
+			case done <- resolution:
+			case <-ctx.Done():
+			}
+			if resolution.Status == rejected {
+				cancel()
+			}
+		}(ind)
+	}
+
+	allStatuses := fulfilled
+	results := make([][]reflect.Value, n, n)
+
+	for j := 0; j < n; j++ {
+		select {
+		case resolution := <-done:
+			if resolution.Status == rejected && allStatuses == rejected {
+				results[resolution.Order] = resolution.Result
+			} else if resolution.Status == rejected {
+				allStatuses = rejected
+				results[resolution.Order] = resolution.Result
+			} else if resolution.Status != rejected && allStatuses == fulfilled {
+				results[resolution.Order] = resolution.Result
+			}
+		case <-ctx.Done():
+			if allStatuses == rejected {
+				return allStatuses, results
+			}
+			return rejected, [][]reflect.Value{{ctxRejection(ctx, p.timeoutSet)}}
+		}
+	}
+
+	return allStatuses, results
+}
+
 // Promise is a generator for the PromiseStruct and it only accepts functions with no input arguments
-func Promise(fn interface{}) PromiseStruct {
+func Promise(fn any) PromiseStruct {
 	if reflect.TypeOf(fn).NumIn() > 0 {
 		fErr := fmt.Errorf("function argument should have no arguments")
 		return PromiseStruct{
@@ -25,8 +188,22 @@ func Promise(fn interface{}) PromiseStruct {
 	return p.runFunc(fn)
 }
 
+// PromiseWithContext is like Promise but ties the returned PromiseStruct to ctx, so that
+// any All or Map later chained onto it reject with ctx.Err() as soon as ctx is done
+func PromiseWithContext(ctx context.Context, fn any) PromiseStruct {
+	if reflect.TypeOf(fn).NumIn() > 0 {
+		fErr := fmt.Errorf("function argument should have no arguments")
+		return PromiseStruct{
+			Status: rejected,
+			Result: []reflect.Value{reflect.ValueOf(fErr)},
+		}
+	}
+	p := promise.WithContext(ctx)
+	return p.runFunc(fn)
+}
+
 // Then runs a given function with the piped in parameters as long as the previous promise was successful
-func (p PromiseStruct) Then(fn interface{}) PromiseStruct {
+func (p PromiseStruct) Then(fn any) PromiseStruct {
 	// Rejected promises should skip chained thens
 	if p.Status == rejected {
 		return p
@@ -36,7 +213,7 @@ func (p PromiseStruct) Then(fn interface{}) PromiseStruct {
 }
 
 // Catch runs a given function if any promise before it failed to complete successfully
-func (p PromiseStruct) Catch(fn interface{}) PromiseStruct {
+func (p PromiseStruct) Catch(fn any) PromiseStruct {
 	// fulfilled promises should skip chained catches
 	if p.Status == fulfilled {
 		return p
@@ -44,53 +221,288 @@ func (p PromiseStruct) Catch(fn interface{}) PromiseStruct {
 	return p.runFunc(fn)
 }
 
-// All takes several functions and runs them in parallel
+// All takes several functions and runs them in parallel. With WithContext applied, a sibling
+// that rejects cancels the rest, and the wait abandons early with ctx.Err() once ctx is done.
+// WithConcurrency caps how many of them run at once.
 // The next chaining function will need to take the input of all function returns in order
-func (p PromiseStruct) All(fn ...interface{}) PromiseStruct {
+func (p PromiseStruct) All(fn ...any) PromiseStruct {
 	// Rejected promises should skip chained thens
 	if p.Status == rejected {
 		return p
 	}
-	done := make(chan PromiseStruct)
-	defer close(done)
-	i := 0
 
-	for _, f := range fn {
-		go func(fptr interface{}, ind int) {
-			done <- PromiseStruct{p.Status, p.Result, ind}.runFunc(fptr)
-		}(f, i)
-		i++
+	allStatuses, results := p.runWorkers(len(fn), func(ind int) (any, []reflect.Value) {
+		return fn[ind], p.Result
+	})
+
+	flattendResults := make([]reflect.Value, 0)
+	for _, res := range results {
+		flattendResults = append(flattendResults, res...)
 	}
 
-	allStatuses := fulfilled
-	results := make([][]reflect.Value, i, i)
+	return PromiseStruct{
+		Status:      allStatuses,
+		Result:      flattendResults,
+		ctx:         p.ctx,
+		cancel:      p.cancel,
+		concurrency: p.concurrency,
+		timeoutSet:  p.timeoutSet,
+	}
+}
+
+// AllSlice is like All, but instead of flattening every function's return values into
+// positional Then arguments it accumulates them into a single typed slice built with
+// buildTypedSlice, so the next chained method can be written as func(xs []T) ... instead of
+// one parameter per function.
+func (p PromiseStruct) AllSlice(fn ...any) PromiseStruct {
+	// Rejected promises should skip chained thens
+	if p.Status == rejected {
+		return p
+	}
 
-	for j := 0; j < i; j++ {
-		resolution := <-done
-		if resolution.Status == rejected && allStatuses == rejected {
-			results[resolution.Order] = resolution.Result
-		} else if resolution.Status == rejected {
-			allStatuses = rejected
-			results[resolution.Order] = resolution.Result
-		} else if resolution.Status != rejected && allStatuses == fulfilled {
-			results[resolution.Order] = resolution.Result
+	allStatuses, results := p.runWorkers(len(fn), func(ind int) (any, []reflect.Value) {
+		return fn[ind], p.Result
+	})
+
+	if allStatuses == rejected {
+		flattendResults := make([]reflect.Value, 0)
+		for _, res := range results {
+			flattendResults = append(flattendResults, res...)
 		}
+		return PromiseStruct{
+			Status:      allStatuses,
+			Result:      flattendResults,
+			ctx:         p.ctx,
+			cancel:      p.cancel,
+			concurrency: p.concurrency,
+			timeoutSet:  p.timeoutSet,
+		}
+	}
+
+	var elemFn any
+	if len(fn) > 0 {
+		elemFn = fn[0]
 	}
 
+	slice, err := buildTypedSlice(results, elemFn)
+	if err != nil {
+		return PromiseStruct{
+			Status:      rejected,
+			Result:      []reflect.Value{reflect.ValueOf(err)},
+			ctx:         p.ctx,
+			cancel:      p.cancel,
+			concurrency: p.concurrency,
+			timeoutSet:  p.timeoutSet,
+		}
+	}
+
+	return PromiseStruct{
+		Status:      fulfilled,
+		Result:      []reflect.Value{slice},
+		ctx:         p.ctx,
+		cancel:      p.cancel,
+		concurrency: p.concurrency,
+		timeoutSet:  p.timeoutSet,
+	}
+}
+
+// Race runs the given functions concurrently, like All, but resolves or rejects with whichever
+// one finishes first. The rest are cancelled through the same context cancellation path used
+// by WithContext, or simply ignored if they are already past the point of observing it.
+func (p PromiseStruct) Race(fn ...any) PromiseStruct {
+	// Rejected promises should skip chained thens
+	if p.Status == rejected {
+		return p
+	}
+
+	if len(fn) == 0 {
+		fErr := fmt.Errorf("Race requires at least one function")
+		return PromiseStruct{
+			Status:      rejected,
+			Result:      []reflect.Value{reflect.ValueOf(fErr)},
+			ctx:         p.ctx,
+			cancel:      p.cancel,
+			concurrency: p.concurrency,
+			timeoutSet:  p.timeoutSet,
+		}
+	}
+
+	parent := p.ctx
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	done := make(chan PromiseStruct, len(fn))
+	for ind, f := range fn {
+		go func(fptr any, ind int) {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+			done <- PromiseStruct{Status: p.Status, Result: p.Result, Order: ind}.runFunc(fptr)
+		}(f, ind)
+	}
+
+	select {
+	case resolution := <-done:
+		return PromiseStruct{
+			Status:      resolution.Status,
+			Result:      resolution.Result,
+			ctx:         p.ctx,
+			cancel:      p.cancel,
+			concurrency: p.concurrency,
+			timeoutSet:  p.timeoutSet,
+		}
+	case <-ctx.Done():
+		return PromiseStruct{
+			Status:      rejected,
+			Result:      []reflect.Value{ctxRejection(ctx, p.timeoutSet)},
+			ctx:         p.ctx,
+			cancel:      p.cancel,
+			concurrency: p.concurrency,
+			timeoutSet:  p.timeoutSet,
+		}
+	}
+}
+
+// Any runs the given functions concurrently and resolves with whichever one fulfills first,
+// mirroring JS Promise.any. It only rejects, with every rejection reason joined via
+// errors.Join, once all of them have rejected.
+func (p PromiseStruct) Any(fn ...any) PromiseStruct {
+	// Rejected promises should skip chained thens
+	if p.Status == rejected {
+		return p
+	}
+
+	if len(fn) == 0 {
+		fErr := fmt.Errorf("Any requires at least one function")
+		return PromiseStruct{
+			Status:      rejected,
+			Result:      []reflect.Value{reflect.ValueOf(fErr)},
+			ctx:         p.ctx,
+			cancel:      p.cancel,
+			concurrency: p.concurrency,
+			timeoutSet:  p.timeoutSet,
+		}
+	}
+
+	parent := p.ctx
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	done := make(chan PromiseStruct, len(fn))
+	for ind, f := range fn {
+		go func(fptr any, ind int) {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+			done <- PromiseStruct{Status: p.Status, Result: p.Result, Order: ind}.runFunc(fptr)
+		}(f, ind)
+	}
+
+	reasons := make([]error, len(fn))
+	for i := 0; i < len(fn); i++ {
+		select {
+		case resolution := <-done:
+			if resolution.Status != rejected {
+				return PromiseStruct{
+					Status:      fulfilled,
+					Result:      resolution.Result,
+					ctx:         p.ctx,
+					cancel:      p.cancel,
+					concurrency: p.concurrency,
+					timeoutSet:  p.timeoutSet,
+				}
+			}
+			if reason, ok := resolution.Result[0].Interface().(error); ok {
+				reasons[resolution.Order] = reason
+			}
+		case <-ctx.Done():
+			return PromiseStruct{
+				Status:      rejected,
+				Result:      []reflect.Value{ctxRejection(ctx, p.timeoutSet)},
+				ctx:         p.ctx,
+				cancel:      p.cancel,
+				concurrency: p.concurrency,
+				timeoutSet:  p.timeoutSet,
+			}
+		}
+	}
+
+	return PromiseStruct{
+		Status:      rejected,
+		Result:      []reflect.Value{reflect.ValueOf(errors.Join(reasons...))},
+		ctx:         p.ctx,
+		cancel:      p.cancel,
+		concurrency: p.concurrency,
+		timeoutSet:  p.timeoutSet,
+	}
+}
+
+// Map takes an array and applies a given function to each element in the array. With
+// WithContext applied, an element that rejects cancels the rest, and the wait abandons early
+// with ctx.Err() once ctx is done. WithConcurrency caps how many elements are processed at once.
+// The return of Map is similar to All, the next chained method will need to handle all passed returns in order
+func (p PromiseStruct) Map(s any, fn any) PromiseStruct {
+	// Rejected promises should skip chained thens
+	if p.Status == rejected {
+		return p
+	}
+
+	// Make sure a slice is received and that it is of the correct type
+	xs := reflect.ValueOf(s)
+	xstype := xs.Type()
+	if xstype.Kind() != reflect.Slice {
+		sErr := fmt.Errorf("first argument should be %s but got %s", reflect.Slice, xstype.Kind())
+		return PromiseStruct{
+			Status: rejected,
+			Result: []reflect.Value{reflect.ValueOf(sErr)},
+		}
+	}
+
+	allStatuses, results := p.runWorkers(xs.Len(), func(ind int) (any, []reflect.Value) {
+		return fn, []reflect.Value{xs.Index(ind)}
+	})
+
 	flattendResults := make([]reflect.Value, 0)
 	for _, res := range results {
 		flattendResults = append(flattendResults, res...)
 	}
 
 	return PromiseStruct{
-		Status: allStatuses,
-		Result: flattendResults,
+		Status:      allStatuses,
+		Result:      flattendResults,
+		ctx:         p.ctx,
+		cancel:      p.cancel,
+		concurrency: p.concurrency,
+		timeoutSet:  p.timeoutSet,
 	}
 }
 
-// Map takes an array and applies a given function to each element in the array
-// The return of Map is similar to All, the next chained method will need to handle all passed returns in order
-func (p PromiseStruct) Map(s interface{}, fn interface{}) PromiseStruct {
+// ThenMap is like Map but takes the array from the previously executed promise
+func (p PromiseStruct) ThenMap(fn any) PromiseStruct {
+	// Rejected promises should skip chained thens
+	if p.Status == rejected {
+		return p
+	}
+
+	mapSlice := p.Result[0].Interface()
+	return p.Map(mapSlice, fn)
+}
+
+// MapSlice is like Map, but instead of flattening every callback's return values into
+// positional Then arguments it accumulates them into a single typed slice built with
+// buildTypedSlice, so the next chained method can be written as func(xs []T) ... even when the
+// input length is only known at runtime.
+func (p PromiseStruct) MapSlice(s any, fn any) PromiseStruct {
 	// Rejected promises should skip chained thens
 	if p.Status == rejected {
 		return p
@@ -107,55 +519,177 @@ func (p PromiseStruct) Map(s interface{}, fn interface{}) PromiseStruct {
 		}
 	}
 
-	done := make(chan PromiseStruct)
-	defer close(done)
-	i := 0
-	for i = 0; i < xs.Len(); i++ {
-		arg := []reflect.Value{xs.Index(i)}
-		go func(fptr interface{}, result []reflect.Value, ind int) {
-			done <- PromiseStruct{p.Status, result, ind}.runFunc(fptr)
-		}(fn, arg, i)
+	allStatuses, results := p.runWorkers(xs.Len(), func(ind int) (any, []reflect.Value) {
+		return fn, []reflect.Value{xs.Index(ind)}
+	})
+
+	if allStatuses == rejected {
+		flattendResults := make([]reflect.Value, 0)
+		for _, res := range results {
+			flattendResults = append(flattendResults, res...)
+		}
+		return PromiseStruct{
+			Status:      allStatuses,
+			Result:      flattendResults,
+			ctx:         p.ctx,
+			cancel:      p.cancel,
+			concurrency: p.concurrency,
+			timeoutSet:  p.timeoutSet,
+		}
 	}
 
-	allStatuses := fulfilled
-	results := make([][]reflect.Value, i, i)
+	// fn is the same function for every element, so the results are always homogeneous
+	slice, _ := buildTypedSlice(results, fn)
+
+	return PromiseStruct{
+		Status:      fulfilled,
+		Result:      []reflect.Value{slice},
+		ctx:         p.ctx,
+		cancel:      p.cancel,
+		concurrency: p.concurrency,
+		timeoutSet:  p.timeoutSet,
+	}
+}
+
+// ThenMapSlice is like MapSlice but takes the array from the previously executed promise
+func (p PromiseStruct) ThenMapSlice(fn any) PromiseStruct {
+	// Rejected promises should skip chained thens
+	if p.Status == rejected {
+		return p
+	}
+
+	mapSlice := p.Result[0].Interface()
+	return p.MapSlice(mapSlice, fn)
+}
 
-	for j := 0; j < i; j++ {
-		resolution := <-done
-		if resolution.Status == rejected && allStatuses == rejected {
-			results[resolution.Order] = resolution.Result
-		} else if resolution.Status == rejected {
-			allStatuses = rejected
-			results[resolution.Order] = resolution.Result
-		} else if resolution.Status != rejected && allStatuses == fulfilled {
-			results[resolution.Order] = resolution.Result
+// Filter takes a slice and a predicate and keeps only the elements for which the predicate
+// returns true. The predicate takes a single element of the slice, or optionally the element
+// along with its index and the slice length like Reduce, and may return an error to reject
+// the promise. The surviving elements are collected into a genuine typed slice so the next
+// chained method can declare a single slice argument instead of a spread of positional ones.
+func (p PromiseStruct) Filter(s any, pred any) PromiseStruct {
+	// Rejected promises should skip chained thens
+	if p.Status == rejected {
+		return p
+	}
+
+	// Make sure a slice is received and that it is of the correct type
+	xs := reflect.ValueOf(s)
+	xstype := xs.Type()
+	if xstype.Kind() != reflect.Slice {
+		sErr := fmt.Errorf("first argument should be %s but got %s", reflect.Slice, xstype.Kind())
+		return PromiseStruct{
+			Status:      rejected,
+			Result:      []reflect.Value{reflect.ValueOf(sErr)},
+			ctx:         p.ctx,
+			cancel:      p.cancel,
+			concurrency: p.concurrency,
+			timeoutSet:  p.timeoutSet,
 		}
 	}
 
-	flattendResults := make([]reflect.Value, 0)
-	for _, res := range results {
-		flattendResults = append(flattendResults, res...)
+	predTyp := reflect.TypeOf(pred)
+	predPtr := reflect.ValueOf(pred)
+
+	// pred must be a function
+	if predPtr.Kind() != reflect.Func {
+		message := fmt.Errorf("was expecting a %s but got %s", reflect.Func, predPtr.Kind())
+		return PromiseStruct{
+			Status:      rejected,
+			Result:      []reflect.Value{reflect.ValueOf(message)},
+			ctx:         p.ctx,
+			cancel:      p.cancel,
+			concurrency: p.concurrency,
+			timeoutSet:  p.timeoutSet,
+		}
+	}
+	// pred must take either just the element, or the element plus its index and the slice length
+	if predTyp.NumIn() != 1 && predTyp.NumIn() != 3 {
+		message := fmt.Errorf("predicate should take 1 or 3 arguments but got %d", predTyp.NumIn())
+		return PromiseStruct{
+			Status:      rejected,
+			Result:      []reflect.Value{reflect.ValueOf(message)},
+			ctx:         p.ctx,
+			cancel:      p.cancel,
+			concurrency: p.concurrency,
+			timeoutSet:  p.timeoutSet,
+		}
+	}
+	withIndex := predTyp.NumIn() == 3
+
+	// pred's first argument must match the slice's element type
+	if predTyp.In(0) != xstype.Elem() {
+		message := fmt.Errorf("Args should be %s but got %s", predTyp.In(0), xstype.Elem())
+		return PromiseStruct{
+			Status:      rejected,
+			Result:      []reflect.Value{reflect.ValueOf(message)},
+			ctx:         p.ctx,
+			cancel:      p.cancel,
+			concurrency: p.concurrency,
+			timeoutSet:  p.timeoutSet,
+		}
+	}
+	if withIndex && (predTyp.In(1).Kind() != reflect.Int || predTyp.In(2).Kind() != reflect.Int) {
+		message := fmt.Errorf("Args should be %s but got %s", reflect.Int, predTyp.In(1))
+		return PromiseStruct{
+			Status:      rejected,
+			Result:      []reflect.Value{reflect.ValueOf(message)},
+			ctx:         p.ctx,
+			cancel:      p.cancel,
+			concurrency: p.concurrency,
+			timeoutSet:  p.timeoutSet,
+		}
+	}
+
+	reflectError := reflect.TypeOf((*error)(nil)).Elem()
+	filtered := reflect.MakeSlice(reflect.SliceOf(xstype.Elem()), 0, xs.Len())
+
+	for i := 0; i < xs.Len(); i++ {
+		args := []reflect.Value{xs.Index(i)}
+		if withIndex {
+			args = append(args, reflect.ValueOf(i), reflect.ValueOf(xs.Len()))
+		}
+
+		y := predPtr.Call(args)
+		if predTyp.NumOut() > 1 && predTyp.Out(1).Implements(reflectError) && !y[1].IsNil() {
+			return PromiseStruct{
+				Status:      rejected,
+				Result:      []reflect.Value{y[1]},
+				ctx:         p.ctx,
+				cancel:      p.cancel,
+				concurrency: p.concurrency,
+				timeoutSet:  p.timeoutSet,
+			}
+		}
+
+		if y[0].Bool() {
+			filtered = reflect.Append(filtered, xs.Index(i))
+		}
 	}
 
 	return PromiseStruct{
-		Status: allStatuses,
-		Result: flattendResults,
+		Status:      fulfilled,
+		Result:      []reflect.Value{filtered},
+		ctx:         p.ctx,
+		cancel:      p.cancel,
+		concurrency: p.concurrency,
+		timeoutSet:  p.timeoutSet,
 	}
 }
 
-// ThenMap is like Map but takes the array from the previously executed promise
-func (p PromiseStruct) ThenMap(fn interface{}) PromiseStruct {
+// ThenFilter is like Filter but takes the slice from the previously executed promise
+func (p PromiseStruct) ThenFilter(pred any) PromiseStruct {
 	// Rejected promises should skip chained thens
 	if p.Status == rejected {
 		return p
 	}
 
-	mapSlice := p.Result[0].Interface()
-	return p.Map(mapSlice, fn)
+	filterSlice := p.Result[0].Interface()
+	return p.Filter(filterSlice, pred)
 }
 
 // Reduce takes a slice and give a function and initial value creates a single value
-func (p PromiseStruct) Reduce(s interface{}, fn interface{}, init interface{}) PromiseStruct {
+func (p PromiseStruct) Reduce(s any, fn any, init any) PromiseStruct {
 	// Rejected promises should skip chained thens
 	if p.Status == rejected {
 		return p
@@ -200,7 +734,7 @@ func (p PromiseStruct) Reduce(s interface{}, fn interface{}, init interface{}) P
 			args[2] = reflect.ValueOf(i)
 		}
 
-		accumulator := func(fptr interface{}, result []reflect.Value) PromiseStruct {
+		accumulator := func(fptr any, result []reflect.Value) PromiseStruct {
 			return PromiseStruct{Status: p.Status, Result: result}.runFunc(fptr)
 		}(fn, args)
 
@@ -217,8 +751,8 @@ func (p PromiseStruct) Reduce(s interface{}, fn interface{}, init interface{}) P
 	}
 }
 
-//ThenReduce is like Reduce but the slice comes from a previous promise
-func (p PromiseStruct) ThenReduce(fn interface{}, init interface{}) PromiseStruct {
+// ThenReduce is like Reduce but the slice comes from a previous promise
+func (p PromiseStruct) ThenReduce(fn any, init any) PromiseStruct {
 	// Rejected promises should skip chained thens
 	if p.Status == rejected {
 		return p
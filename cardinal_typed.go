@@ -0,0 +1,175 @@
+// Package cardinal is a Promise library written in Go using the reflect package.
+//
+// Before getting started there a few basic rules you need to understand:
+//
+// 	1. To reject a promise return a non-nil error
+//
+// 	2. Nil errors will not be piped into the next chained function
+package cardinal
+
+// Typed is a generics-based alternative to PromiseStruct for Go 1.18+ call sites: the value and
+// error produced by a function flow through compile-time checked type parameters instead of
+// reflect.Value, so there is no runtime "Args should be X but got Y" rejection to guard against.
+// PromiseStruct remains the right choice for dynamic call sites that only know their shapes at
+// runtime.
+type Typed[T any] struct {
+	value T
+	err   error
+}
+
+// Get returns the value and error currently held by p
+func (p Typed[T]) Get() (T, error) {
+	return p.value, p.err
+}
+
+// Of is a generator for Typed, running fn immediately to produce the initial value or error
+func Of[T any](fn func() (T, error)) Typed[T] {
+	v, err := fn()
+	return Typed[T]{value: v, err: err}
+}
+
+// ThenT runs fn with the value held by p as long as p has not already failed
+func ThenT[T, U any](p Typed[T], fn func(T) (U, error)) Typed[U] {
+	if p.err != nil {
+		return Typed[U]{err: p.err}
+	}
+	v, err := fn(p.value)
+	return Typed[U]{value: v, err: err}
+}
+
+// CatchT runs fn to recover to a fallback value if p has failed
+func CatchT[T any](p Typed[T], fn func(error) (T, error)) Typed[T] {
+	if p.err == nil {
+		return p
+	}
+	v, err := fn(p.err)
+	return Typed[T]{value: v, err: err}
+}
+
+// Pair2 holds the results of AllT2 in order
+type Pair2[A, B any] struct {
+	A A
+	B B
+}
+
+// AllT2 runs fnA and fnB in parallel and resolves with both of their results in order, or,
+// once both have returned, rejects with the error of the first failed function in argument order
+func AllT2[A, B any](fnA func() (A, error), fnB func() (B, error)) Typed[Pair2[A, B]] {
+	var a A
+	var b B
+	errs := make([]error, 2)
+
+	done := make(chan struct{}, 2)
+	go func() {
+		a, errs[0] = fnA()
+		done <- struct{}{}
+	}()
+	go func() {
+		b, errs[1] = fnB()
+		done <- struct{}{}
+	}()
+	<-done
+	<-done
+
+	for _, err := range errs {
+		if err != nil {
+			return Typed[Pair2[A, B]]{err: err}
+		}
+	}
+	return Typed[Pair2[A, B]]{value: Pair2[A, B]{A: a, B: b}}
+}
+
+// Triple3 holds the results of AllT3 in order
+type Triple3[A, B, C any] struct {
+	A A
+	B B
+	C C
+}
+
+// AllT3 runs fnA, fnB, and fnC in parallel and resolves with all three results in order, or,
+// once all three have returned, rejects with the error of the first failed function in argument order
+func AllT3[A, B, C any](fnA func() (A, error), fnB func() (B, error), fnC func() (C, error)) Typed[Triple3[A, B, C]] {
+	var a A
+	var b B
+	var c C
+	errs := make([]error, 3)
+
+	done := make(chan struct{}, 3)
+	go func() {
+		a, errs[0] = fnA()
+		done <- struct{}{}
+	}()
+	go func() {
+		b, errs[1] = fnB()
+		done <- struct{}{}
+	}()
+	go func() {
+		c, errs[2] = fnC()
+		done <- struct{}{}
+	}()
+	<-done
+	<-done
+	<-done
+
+	for _, err := range errs {
+		if err != nil {
+			return Typed[Triple3[A, B, C]]{err: err}
+		}
+	}
+	return Typed[Triple3[A, B, C]]{value: Triple3[A, B, C]{A: a, B: b, C: c}}
+}
+
+// MapT applies fn to every element of the slice held by p in parallel, resolving with the
+// results in order, or rejecting with the first error encountered
+func MapT[T, U any](p Typed[[]T], fn func(T) (U, error)) Typed[[]U] {
+	if p.err != nil {
+		return Typed[[]U]{err: p.err}
+	}
+
+	type result struct {
+		i   int
+		v   U
+		err error
+	}
+
+	done := make(chan result, len(p.value))
+	for i, elem := range p.value {
+		go func(i int, elem T) {
+			v, err := fn(elem)
+			done <- result{i: i, v: v, err: err}
+		}(i, elem)
+	}
+
+	out := make([]U, len(p.value))
+	var firstErr error
+	for range p.value {
+		r := <-done
+		if r.err != nil && firstErr == nil {
+			firstErr = r.err
+		}
+		out[r.i] = r.v
+	}
+
+	if firstErr != nil {
+		return Typed[[]U]{err: firstErr}
+	}
+	return Typed[[]U]{value: out}
+}
+
+// ReduceT folds the slice held by p into a single value using fn and the initial value init,
+// stopping at the first error fn returns
+func ReduceT[T, U any](p Typed[[]T], fn func(acc U, elem T) (U, error), init U) Typed[U] {
+	if p.err != nil {
+		return Typed[U]{err: p.err}
+	}
+
+	acc := init
+	for _, elem := range p.value {
+		v, err := fn(acc, elem)
+		if err != nil {
+			return Typed[U]{err: err}
+		}
+		acc = v
+	}
+	return Typed[U]{value: acc}
+}
@@ -1,8 +1,10 @@
 package cardinal
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 )
 
@@ -143,6 +145,224 @@ func ExamplePromiseStruct_All_then() {
 	// 81
 }
 
+// should reject with ctx.Err() without running any worker once ctx is already done
+func ExamplePromiseStruct_WithContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	stuff := Promise(func() int {
+		return 3
+	}).
+		WithContext(ctx).
+		All(
+			func(x int) (int, error) {
+				return x + 3, nil
+			},
+			func(x int) (int, error) {
+				return x * x, nil
+			})
+	fmt.Println(stuff.Status == rejected)
+	fmt.Println(stuff.Result[0])
+	// Output:
+	// true
+	// context canceled
+}
+
+// should never run more than n workers at once
+func ExamplePromiseStruct_WithConcurrency() {
+	var running, maxRunning int32
+	nums := []int{1, 2, 3, 4}
+
+	stuff := Promise(func() {}).
+		WithConcurrency(2).
+		Map(nums, func(x int) (int, error) {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				cur := atomic.LoadInt32(&maxRunning)
+				if n <= cur || atomic.CompareAndSwapInt32(&maxRunning, cur, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return x * x, nil
+		})
+
+	fmt.Println(maxRunning <= 2)
+	fmt.Println(stuff.Status == fulfilled)
+	// Output:
+	// true
+	// true
+}
+
+// should resolve with whichever function finishes first
+func ExamplePromiseStruct_Race() {
+	stuff := Promise(func() int {
+		return 3
+	}).
+		Race(
+			func(x int) (int, error) {
+				time.Sleep(50 * time.Millisecond)
+				return x + 3, nil
+			},
+			func(x int) (int, error) {
+				return x * x, nil
+			})
+	fmt.Println(stuff.Result[0])
+	// Output:
+	// 9
+}
+
+// should resolve with the first fulfilled function, ignoring rejections
+func ExamplePromiseStruct_Any() {
+	stuff := Promise(func() int {
+		return 3
+	}).
+		Any(
+			func(x int) (int, error) {
+				return 0, errors.New("not this one")
+			},
+			func(x int) (int, error) {
+				time.Sleep(50 * time.Millisecond)
+				return x * x, nil
+			})
+	fmt.Println(stuff.Result[0])
+	// Output:
+	// 9
+}
+
+// should reject instead of blocking forever when given no functions
+func ExamplePromiseStruct_Race_empty() {
+	stuff := Promise(func() int {
+		return 3
+	}).
+		Race()
+	fmt.Println(stuff.Status == rejected)
+	fmt.Println(stuff.Result[0])
+	// Output:
+	// true
+	// Race requires at least one function
+}
+
+// should reject instead of panicking on a nil error when given no functions
+func ExamplePromiseStruct_Any_empty() {
+	stuff := Promise(func() int {
+		return 3
+	}).
+		Any()
+	fmt.Println(stuff.Status == rejected)
+	fmt.Println(stuff.Result[0])
+	// Output:
+	// true
+	// Any requires at least one function
+}
+
+// should reject with a joined error once every function has rejected
+func ExamplePromiseStruct_Any_allRejected() {
+	stuff := Promise(func() int {
+		return 3
+	}).
+		Any(
+			func(x int) (int, error) {
+				return 0, errors.New("first reason")
+			},
+			func(x int) (int, error) {
+				return 0, errors.New("second reason")
+			})
+	fmt.Println(stuff.Status == rejected)
+	fmt.Println(stuff.Result[0])
+	// Output:
+	// true
+	// first reason
+	// second reason
+}
+
+// should reject with ErrTimeout once the deadline elapses before the chain settles
+func ExamplePromiseStruct_Timeout() {
+	stuff := Promise(func() int {
+		return 3
+	}).
+		Timeout(10 * time.Millisecond).
+		All(func(x int) (int, error) {
+			time.Sleep(50 * time.Millisecond)
+			return x, nil
+		})
+	fmt.Println(stuff.Status == rejected)
+	fmt.Println(stuff.Result[0].Interface() == ErrTimeout)
+	// Output:
+	// true
+	// true
+}
+
+// should surface the caller's own context.DeadlineExceeded as-is, not ErrTimeout, when the
+// deadline comes from a context passed into WithContext rather than this library's Timeout
+func ExamplePromiseStruct_WithContext_deadline() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	stuff := Promise(func() int {
+		return 3
+	}).
+		WithContext(ctx).
+		All(func(x int) (int, error) {
+			time.Sleep(50 * time.Millisecond)
+			return x, nil
+		})
+	fmt.Println(stuff.Status == rejected)
+	fmt.Println(errors.Is(stuff.Result[0].Interface().(error), context.DeadlineExceeded))
+	fmt.Println(stuff.Result[0].Interface() == ErrTimeout)
+	// Output:
+	// true
+	// true
+	// false
+}
+
+// should run several functions in parallel and pass their results on as a single typed slice
+func ExamplePromiseStruct_AllSlice() {
+	stuff := Promise(func() int {
+		return 3
+	}).
+		AllSlice(
+			func(x int) (int, error) {
+				fmt.Println(x)
+				time.Sleep(50 * time.Millisecond) //Guarantee Order
+				return x + 3, nil
+			},
+			func(x int) (int, error) {
+				fmt.Println(x)
+				return x * x, nil
+			}).
+		Then(func(xs []int) []int {
+			fmt.Println(xs)
+			return xs
+		})
+	fmt.Println(stuff.Result[0])
+	// Output:
+	// 3
+	// 3
+	// [6 9]
+	// [6 9]
+}
+
+// should reject instead of panicking when the functions' return types don't agree
+func ExamplePromiseStruct_AllSlice_mismatchedTypes() {
+	stuff := Promise(func() int {
+		return 3
+	}).
+		AllSlice(
+			func(x int) (int, error) {
+				return x, nil
+			},
+			func(x int) (string, error) {
+				return fmt.Sprint(x), nil
+			})
+	fmt.Println(stuff.Status == rejected)
+	fmt.Println(stuff.Result[0])
+	// Output:
+	// true
+	// AllSlice requires every function to return the same type, but got int and string
+}
+
 // Should map a given array with a given function
 func ExamplePromiseStruct_Map() {
 	fruits := []string{"apples", "bananas", "oranges", "cherries"}
@@ -160,6 +380,56 @@ func ExamplePromiseStruct_Map() {
 	// bananas are not a fruit and cherries are not a fruit
 }
 
+// should map a given array with a given function and pass the results on as a single typed slice
+func ExamplePromiseStruct_MapSlice() {
+	fruits := []string{"apples", "bananas", "oranges", "cherries"}
+	stuff := Promise(func() {}).
+		MapSlice(fruits, func(s string) string {
+			return s + " are not a fruit"
+		}).
+		Then(func(xs []string) []string {
+			fmt.Println(xs[0])
+			return xs
+		})
+	fmt.Println(stuff.Result[0])
+	// Output:
+	// apples are not a fruit
+	// [apples are not a fruit bananas are not a fruit oranges are not a fruit cherries are not a fruit]
+}
+
+// should stay typed even when the input slice is empty, instead of rejecting the next Then
+func ExamplePromiseStruct_MapSlice_empty() {
+	stuff := Promise(func() {}).
+		MapSlice([]string{}, func(s string) (string, error) {
+			return s, nil
+		}).
+		Then(func(xs []string) []string {
+			return xs
+		})
+	fmt.Println(stuff.Status == fulfilled)
+	fmt.Println(stuff.Result[0])
+	// Output:
+	// true
+	// []
+}
+
+// should map a piped array with a given function and pass the results on as a single typed slice
+func ExamplePromiseStruct_ThenMapSlice() {
+	fruits := []string{"apples", "bananas", "oranges", "cherries"}
+	stuff := Promise(func() []string { return fruits }).
+		ThenMapSlice(func(s string) string {
+			return s + " are not a fruit"
+		}).
+		Then(func(xs []string) []string {
+			fmt.Println(xs[0])
+			return xs
+		})
+	fmt.Println(stuff.Result[0])
+	// Output:
+	// apples are not a fruit
+	// [apples are not a fruit bananas are not a fruit oranges are not a fruit cherries are not a fruit]
+}
+
 // should map a piped array with a given function
 func ExamplePromiseStruct_ThenMap() {
 	fruits := []string{"apples", "bananas", "oranges", "cherries"}
@@ -177,6 +447,75 @@ func ExamplePromiseStruct_ThenMap() {
 	// bananas are not a fruit and cherries are not a fruit
 }
 
+// should keep only the elements matching a predicate
+func ExamplePromiseStruct_Filter() {
+	fruits := []string{"apples", "bananas", "oranges", "cherries"}
+	stuff := Promise(func() {}).
+		Filter(fruits, func(s string) bool {
+			return len(s) > 6
+		}).
+		Then(func(xs []string) []string {
+			fmt.Println(xs)
+			return xs
+		})
+	fmt.Println(stuff.Result[0])
+	// Output:
+	// [bananas oranges cherries]
+	// [bananas oranges cherries]
+}
+
+// should filter a piped array with a given predicate
+func ExamplePromiseStruct_ThenFilter() {
+	fruits := []string{"apples", "bananas", "oranges", "cherries"}
+	stuff := Promise(func() []string { return fruits }).
+		ThenFilter(func(s string, i int, l int) bool {
+			return i != l-1
+		}).
+		Then(func(xs []string) []string {
+			fmt.Println(xs)
+			return xs
+		})
+	fmt.Println(stuff.Result[0])
+	// Output:
+	// [apples bananas oranges]
+	// [apples bananas oranges]
+}
+
+// should reject instead of panicking when the predicate's argument doesn't match the slice's element type
+func ExamplePromiseStruct_Filter_typeMismatch() {
+	fruits := []string{"apples", "bananas"}
+	stuff := Promise(func() {}).
+		Filter(fruits, func(n int) bool {
+			return n > 0
+		})
+	fmt.Println(stuff.Status == rejected)
+	fmt.Println(stuff.Result[0])
+	// Output:
+	// true
+	// Args should be int but got string
+}
+
+// should carry WithContext/WithConcurrency through Filter so a later All still observes them
+func ExamplePromiseStruct_Filter_withContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	fruits := []string{"apples", "bananas", "oranges", "cherries"}
+	stuff := Promise(func() {}).
+		WithContext(ctx).
+		Filter(fruits, func(s string) bool {
+			return len(s) > 6
+		}).
+		All(func(xs []string) (string, error) {
+			return fmt.Sprint(xs), nil
+		})
+	fmt.Println(stuff.Status == rejected)
+	fmt.Println(stuff.Result[0])
+	// Output:
+	// true
+	// context canceled
+}
+
 //should reduce a given array with a given function
 func ExamplePromiseStruct_Reduce() {
 	fruits := []string{"apples", "bananas", "oranges", "cherries"}
@@ -204,3 +543,80 @@ func ExamplePromiseStruct_ThenReduce() {
 	// Output:
 	// grapes apples bananas oranges cherries
 }
+
+// should chain typed functions with compile-time argument checking
+func ExampleThenT() {
+	stuff := ThenT(
+		Of(func() (int, error) {
+			return 3, nil
+		}),
+		func(x int) (int, error) {
+			fmt.Println(x)
+			return x * x, nil
+		})
+	v, err := stuff.Get()
+	fmt.Println(v, err)
+	// Output:
+	// 3
+	// 9 <nil>
+}
+
+// should stop the chain and recover with CatchT once an error is returned
+func ExampleCatchT() {
+	stuff := CatchT(
+		ThenT(
+			Of(func() (int, error) {
+				return 3, nil
+			}),
+			func(x int) (int, error) {
+				return 0, errors.New("should show up in CatchT")
+			}),
+		func(e error) (int, error) {
+			fmt.Println(e)
+			return -1, nil
+		})
+	v, err := stuff.Get()
+	fmt.Println(v, err)
+	// Output:
+	// should show up in CatchT
+	// -1 <nil>
+}
+
+// should run two typed functions in parallel and resolve with both results in order
+func ExampleAllT2() {
+	stuff := AllT2(
+		func() (int, error) { return 3, nil },
+		func() (string, error) { return "apples", nil })
+	v, err := stuff.Get()
+	fmt.Println(v, err)
+	// Output:
+	// {3 apples} <nil>
+}
+
+// should map a typed slice in parallel and preserve order
+func ExampleMapT() {
+	fruits := Of(func() ([]string, error) {
+		return []string{"apples", "bananas", "oranges", "cherries"}, nil
+	})
+	stuff := MapT(fruits, func(s string) (string, error) {
+		return s + " are not a fruit", nil
+	})
+	v, err := stuff.Get()
+	fmt.Println(v, err)
+	// Output:
+	// [apples are not a fruit bananas are not a fruit oranges are not a fruit cherries are not a fruit] <nil>
+}
+
+// should reduce a typed slice with a given function and initial value
+func ExampleReduceT() {
+	fruits := Of(func() ([]string, error) {
+		return []string{"apples", "bananas", "oranges", "cherries"}, nil
+	})
+	stuff := ReduceT(fruits, func(acc string, s string) (string, error) {
+		return acc + " " + s, nil
+	}, "grapes")
+	v, err := stuff.Get()
+	fmt.Println(v, err)
+	// Output:
+	// grapes apples bananas oranges cherries <nil>
+}